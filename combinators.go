@@ -0,0 +1,80 @@
+package goture
+
+import (
+	"context"
+	"time"
+)
+
+// Then returns a new Goture that waits for f to complete and, if it
+// succeeded, runs fn. If f failed, Then short-circuits and propagates f's
+// error without running fn.
+//
+// Example:
+//
+//	pipeline := NewGoture(ctx, fetch).Then(func(ctx context.Context) error {
+//	    return store(ctx)
+//	})
+//	err := pipeline.Wait()
+func (f Goture) Then(fn func(ctx context.Context) error) Goture {
+	// context.Background(), not f.ctx, is the parent here: deriving from
+	// f.ctx would auto-cancel this Goture's context with f's own cause the
+	// instant f completes, racing ahead of (and discarding) fn's outcome.
+	return NewGoture(context.Background(), func(ctx context.Context) error {
+		if err := f.Wait(); err != nil {
+			return err
+		}
+		return fn(ctx)
+	})
+}
+
+// Recover returns a new Goture that succeeds if f succeeds, or otherwise
+// gives fn a chance to convert f's error into a different error, including
+// nil to turn a failure into a success.
+func (f Goture) Recover(fn func(error) error) Goture {
+	return NewGoture(context.Background(), func(ctx context.Context) error {
+		if err := f.Wait(); err != nil {
+			return fn(err)
+		}
+		return nil
+	})
+}
+
+// WithTimeout returns a new Goture that fails with context.DeadlineExceeded
+// if f does not complete within d.
+func (f Goture) WithTimeout(d time.Duration) Goture {
+	return NewGoture(context.Background(), func(ctx context.Context) error {
+		timeoutCtx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		done := make(chan error, 1)
+		go func() { done <- f.Wait() }()
+
+		select {
+		case err := <-done:
+			return err
+		case <-timeoutCtx.Done():
+			return timeoutCtx.Err()
+		}
+	})
+}
+
+// Map returns a new GotureT[U] that waits for f to complete and, if it
+// succeeded, applies fn to its result. If f failed, Map short-circuits and
+// propagates f's error without running fn.
+//
+// Example:
+//
+//	sizes := Map(fetchGoture, func(ctx context.Context, body []byte) (int, error) {
+//	    return len(body), nil
+//	})
+//	n, err := sizes.Wait()
+func Map[T, U any](f GotureT[T], fn func(ctx context.Context, v T) (U, error)) GotureT[U] {
+	return NewGotureT(context.Background(), func(ctx context.Context) (U, error) {
+		v, err := f.Wait()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(ctx, v)
+	})
+}
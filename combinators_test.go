@@ -0,0 +1,83 @@
+package goture
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestThenShortCircuitsOnUpstreamError(t *testing.T) {
+	errBoom := errors.New("boom")
+	var ran bool
+	chain := NewGoture(context.Background(), func(ctx context.Context) error { return errBoom }).
+		Then(func(ctx context.Context) error {
+			ran = true
+			return nil
+		})
+	if err := chain.Wait(); !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+	if ran {
+		t.Fatal("Then's fn should not run when upstream failed")
+	}
+}
+
+func TestThenRunsAfterUpstreamSuccess(t *testing.T) {
+	chain := NewGoture(context.Background(), func(ctx context.Context) error { return nil }).
+		Then(func(ctx context.Context) error { return nil })
+	if err := chain.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRecoverConvertsError(t *testing.T) {
+	errA := errors.New("a")
+	chain := NewGoture(context.Background(), func(ctx context.Context) error { return errA }).
+		Recover(func(err error) error { return nil })
+	if err := chain.Wait(); err != nil {
+		t.Fatalf("expected recovered error to be nil, got %v", err)
+	}
+}
+
+func TestWithTimeoutFiresOnSlowUpstream(t *testing.T) {
+	slow := NewGoture(context.Background(), func(ctx context.Context) error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	})
+	if err := slow.WithTimeout(10 * time.Millisecond).Wait(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWithTimeoutPassesThroughFastUpstream(t *testing.T) {
+	fast := NewGoture(context.Background(), func(ctx context.Context) error { return nil })
+	if err := fast.WithTimeout(time.Second).Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMapAppliesFnOnSuccess(t *testing.T) {
+	f := NewGotureT(context.Background(), func(ctx context.Context) (int, error) { return 5, nil })
+	m := Map(f, func(ctx context.Context, v int) (int, error) { return v * 2, nil })
+	v, err := m.Wait()
+	if err != nil || v != 10 {
+		t.Fatalf("expected (10, nil), got (%d, %v)", v, err)
+	}
+}
+
+func TestMapShortCircuitsOnError(t *testing.T) {
+	errBoom := errors.New("boom")
+	f := NewGotureT(context.Background(), func(ctx context.Context) (int, error) { return 0, errBoom })
+	var ran bool
+	m := Map(f, func(ctx context.Context, v int) (int, error) {
+		ran = true
+		return v, nil
+	})
+	if _, err := m.Wait(); !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+	if ran {
+		t.Fatal("Map's fn should not run when upstream failed")
+	}
+}
@@ -23,7 +23,8 @@ type Task func(ctx context.Context) error
 
 // Goture represents a future that will complete when the associated task finishes
 type Goture struct {
-	ctx context.Context
+	ctx     context.Context
+	repanic bool
 }
 
 // Wait blocks until the associated task completes and returns any error that occurred.
@@ -34,6 +35,9 @@ type Goture struct {
 //   - Returns nil if the task completed successfully
 //   - Returns the actual error if the task failed
 //   - Handles panic recovery from the executed task
+//   - If the future was created with GotureOptions.Repanic and the task
+//     panicked, re-panics the captured *PanicError on the calling goroutine
+//     instead of returning it
 //
 // Returns:
 //   - error: nil on success, actual error on failure
@@ -50,6 +54,11 @@ func (f Goture) Wait() error {
 	if _, ok := cause.(SuccessResult); ok {
 		return nil
 	}
+	if f.repanic {
+		if pe, ok := cause.(*PanicError); ok {
+			panic(pe)
+		}
+	}
 	return cause
 }
 
@@ -94,6 +103,23 @@ func NewGoture(ctx context.Context, fn Task) Goture {
 	return Goture{ctx: localCtx}
 }
 
+// GotureOptions configures optional behavior for NewGotureWithOptions.
+type GotureOptions struct {
+	// Repanic causes Wait to re-panic the captured *PanicError on the
+	// calling goroutine, instead of returning it as an ordinary error, once
+	// the task has panicked.
+	Repanic bool
+}
+
+// NewGotureWithOptions is like NewGoture but accepts GotureOptions
+// controlling optional behavior such as re-panicking a task's panic on
+// Wait.
+func NewGotureWithOptions(ctx context.Context, fn Task, opts GotureOptions) Goture {
+	future := NewGoture(ctx, fn)
+	future.repanic = opts.Repanic
+	return future
+}
+
 // NewParallelGoture creates a new Goture that executes all given tasks concurrently.
 // This function enables efficient parallel processing by running multiple tasks
 // simultaneously and waiting for all of them to complete.
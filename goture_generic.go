@@ -0,0 +1,128 @@
+package goture
+
+import (
+	"context"
+)
+
+// TaskT represents a function that can be executed asynchronously and
+// produces a typed result alongside the usual error.
+type TaskT[T any] func(ctx context.Context) (T, error)
+
+// GotureT represents a future that will complete with a typed result once
+// the associated task finishes.
+type GotureT[T any] struct {
+	ctx    context.Context
+	result *T
+}
+
+// Wait blocks until the associated task completes and returns its result
+// together with any error that occurred. On failure (or cancellation) it
+// returns the zero value of T alongside the cause.
+//
+// Example:
+//
+//	future := NewGotureT(ctx, someTypedTask)
+//	value, err := future.Wait()
+func (f GotureT[T]) Wait() (T, error) {
+	<-f.ctx.Done()
+	cause := context.Cause(f.ctx)
+	if _, ok := cause.(SuccessResult); ok {
+		return *f.result, nil
+	}
+	var zero T
+	return zero, cause
+}
+
+// Result returns the future's result without blocking. ok is false, and the
+// returned value is the zero value of T, if the future has not completed
+// successfully yet (including the case where it failed).
+func (f GotureT[T]) Result() (value T, ok bool) {
+	select {
+	case <-f.ctx.Done():
+		cause := context.Cause(f.ctx)
+		if _, done := cause.(SuccessResult); done {
+			return *f.result, true
+		}
+	default:
+	}
+	var zero T
+	return zero, false
+}
+
+// NewGotureT creates a new GotureT[T] that executes the given typed task
+// asynchronously. The task begins execution immediately in a separate
+// goroutine upon creation.
+//
+// Behavior mirrors NewGoture: panics are recovered and converted to errors,
+// and cancellation of ctx propagates to the running task.
+func NewGotureT[T any](ctx context.Context, fn TaskT[T]) GotureT[T] {
+	var localCtx, cancel = context.WithCancelCause(ctx)
+	var result T
+	go func() {
+		defer recoverCancel(cancel)
+		r, err := fn(localCtx)
+		if err != nil {
+			cancel(err)
+			return
+		}
+		result = r
+		cancel(SuccessResult{})
+	}()
+	return GotureT[T]{ctx: localCtx, result: &result}
+}
+
+// parallelOutcomeT carries the per-task error from a NewParallelGotureT
+// worker back to the collecting goroutine, identified by its task index so
+// the result slice can be filled in order.
+type parallelOutcomeT struct {
+	index int
+	err   error
+}
+
+// NewParallelGotureT creates a new GotureT[[]T] that executes all given typed
+// tasks concurrently and, once every task has completed, resolves to a slice
+// of their results in the same order as tasks. It waits for ALL tasks to
+// complete, returning the first error encountered if any task failed.
+func NewParallelGotureT[T any](parentCtx context.Context, tasks ...TaskT[T]) GotureT[[]T] {
+	if len(tasks) == 0 {
+		localCtx, cancel := context.WithCancelCause(parentCtx)
+		results := []T{}
+		cancel(SuccessResult{})
+		return GotureT[[]T]{ctx: localCtx, result: &results}
+	}
+
+	var localCtx, cancel = context.WithCancelCause(parentCtx)
+	results := make([]T, len(tasks))
+	completed := make(chan parallelOutcomeT, len(tasks))
+
+	for i, fn := range tasks {
+		go func(index int, task TaskT[T]) {
+			defer func() {
+				if r := recover(); r != nil {
+					completed <- parallelOutcomeT{index: index, err: makeErrorFromPanic(r)}
+				}
+			}()
+			r, err := task(localCtx)
+			if err == nil {
+				results[index] = r
+			}
+			completed <- parallelOutcomeT{index: index, err: err}
+		}(i, fn)
+	}
+
+	go func() {
+		var firstError error
+		for i := 0; i < len(tasks); i++ {
+			if o := <-completed; o.err != nil && firstError == nil {
+				firstError = o.err
+			}
+		}
+		if firstError != nil {
+			cancel(firstError)
+		} else {
+			cancel(SuccessResult{})
+		}
+	}()
+
+	return GotureT[[]T]{ctx: localCtx, result: &results}
+}
@@ -0,0 +1,98 @@
+package goture
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewParallelGotureTPreservesOrder(t *testing.T) {
+	f := NewParallelGotureT(context.Background(),
+		func(ctx context.Context) (int, error) {
+			time.Sleep(20 * time.Millisecond)
+			return 1, nil
+		},
+		func(ctx context.Context) (int, error) { return 2, nil },
+		func(ctx context.Context) (int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return 3, nil
+		},
+	)
+
+	values, err := f.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int{1, 2, 3}
+	if len(values) != len(want) {
+		t.Fatalf("expected %v, got %v", want, values)
+	}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, values)
+		}
+	}
+}
+
+func TestNewParallelGotureTFirstErrorWins(t *testing.T) {
+	errBoom := errors.New("boom")
+	f := NewParallelGotureT(context.Background(),
+		func(ctx context.Context) (int, error) { return 1, nil },
+		func(ctx context.Context) (int, error) { return 0, errBoom },
+		func(ctx context.Context) (int, error) { return 3, nil },
+	)
+
+	if _, err := f.Wait(); !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+}
+
+func TestNewParallelGotureTPanicProducesPanicError(t *testing.T) {
+	f := NewParallelGotureT(context.Background(),
+		func(ctx context.Context) (int, error) { return 1, nil },
+		func(ctx context.Context) (int, error) { panic("kaboom") },
+	)
+
+	_, err := f.Wait()
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *PanicError, got %v (%T)", err, err)
+	}
+	if pe.Value != "kaboom" {
+		t.Fatalf("expected Value %q, got %v", "kaboom", pe.Value)
+	}
+	if len(pe.Stack) == 0 {
+		t.Fatal("expected a non-empty stack trace")
+	}
+}
+
+func TestGotureTResultTransitionsFromFalseToTrue(t *testing.T) {
+	release := make(chan struct{})
+	f := NewGotureT(context.Background(), func(ctx context.Context) (int, error) {
+		<-release
+		return 9, nil
+	})
+
+	if v, ok := f.Result(); ok || v != 0 {
+		t.Fatalf("expected (0,false) before completion, got (%d,%v)", v, ok)
+	}
+
+	close(release)
+	if _, err := f.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if v, ok := f.Result(); !ok || v != 9 {
+		t.Fatalf("expected (9,true) after completion, got (%d,%v)", v, ok)
+	}
+}
+
+func TestGotureTResultStaysFalseOnFailure(t *testing.T) {
+	errBoom := errors.New("boom")
+	f := NewGotureT(context.Background(), func(ctx context.Context) (int, error) { return 0, errBoom })
+	f.Wait()
+	if v, ok := f.Result(); ok || v != 0 {
+		t.Fatalf("expected (0,false) on a failed future, got (%d,%v)", v, ok)
+	}
+}
@@ -0,0 +1,80 @@
+package goture
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWaitReturnsPanicErrorWithStackAndValue(t *testing.T) {
+	f := NewGoture(context.Background(), func(ctx context.Context) error { panic("kaboom") })
+
+	err := f.Wait()
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *PanicError, got %v (%T)", err, err)
+	}
+	if pe.Value != "kaboom" {
+		t.Fatalf("expected Value %q, got %v", "kaboom", pe.Value)
+	}
+	if len(pe.Stack) == 0 {
+		t.Fatal("expected a non-empty stack trace")
+	}
+}
+
+func TestNewGotureWithOptionsRepanicsOnCallingGoroutine(t *testing.T) {
+	f := NewGotureWithOptions(context.Background(), func(ctx context.Context) error {
+		panic("again")
+	}, GotureOptions{Repanic: true})
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected Wait to re-panic")
+		}
+		pe, ok := r.(*PanicError)
+		if !ok {
+			t.Fatalf("expected to recover a *PanicError, got %v (%T)", r, r)
+		}
+		if pe.Value != "again" {
+			t.Fatalf("expected Value %q, got %v", "again", pe.Value)
+		}
+	}()
+	f.Wait()
+	t.Fatal("unreachable: Wait should have panicked")
+}
+
+func TestNewGotureWithOptionsReturnsErrorWhenRepanicDisabled(t *testing.T) {
+	f := NewGotureWithOptions(context.Background(), func(ctx context.Context) error {
+		panic("quiet")
+	}, GotureOptions{Repanic: false})
+
+	err := f.Wait()
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *PanicError to be returned, not panicked, got %v (%T)", err, err)
+	}
+}
+
+func TestPanicErrorUnwrapsUnderlyingError(t *testing.T) {
+	errBoom := errors.New("boom")
+	f := NewGoture(context.Background(), func(ctx context.Context) error { panic(errBoom) })
+
+	err := f.Wait()
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected errors.Is to see through PanicError to errBoom, got %v", err)
+	}
+}
+
+func TestPanicErrorDoesNotUnwrapNonErrorValue(t *testing.T) {
+	f := NewGoture(context.Background(), func(ctx context.Context) error { panic(42) })
+
+	err := f.Wait()
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *PanicError, got %v (%T)", err, err)
+	}
+	if errors.Unwrap(pe) != nil {
+		t.Fatalf("expected Unwrap to return nil for a non-error panic value, got %v", errors.Unwrap(pe))
+	}
+}
@@ -3,10 +3,42 @@ package goture
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
 )
 
-// recoverCancel is a panic recovery function that converts panics to errors
-// and cancels the context with the appropriate error cause.
+// PanicError wraps a value recovered from a panic together with the stack
+// trace captured at the point of recovery, so that a task panic surfaces
+// with enough information to locate it instead of a bare "%v" string.
+type PanicError struct {
+	// Value is whatever was passed to panic().
+	Value any
+	// Stack is the stack trace captured via debug.Stack() at the point the
+	// panic was recovered.
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("goture: task panicked: %v\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns Value if it is itself an error, so that errors.Is/As can
+// see through a PanicError to the original error that was panicked with.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// newPanicError captures the current stack trace and wraps r into a
+// PanicError. It must be called from the deferred recover, while the
+// panicking goroutine's frames are still live.
+func newPanicError(r any) *PanicError {
+	return &PanicError{Value: r, Stack: debug.Stack()}
+}
+
+// recoverCancel is a panic recovery function that converts panics to a
+// PanicError (preserving the panic value and its stack trace) and cancels
+// the context with it as the cause.
 //
 // This function is designed to be used with defer in goroutines to ensure
 // that any panic is properly handled and converted to an error that can
@@ -17,8 +49,7 @@ import (
 //
 // Behavior:
 //   - Recovers from panic if one occurred
-//   - Converts error-type panics directly to cancellation cause
-//   - Converts non-error panics to formatted error messages
+//   - Wraps the panic value, whatever its type, into a *PanicError
 //   - Does nothing if no panic occurred
 //
 // Usage:
@@ -26,16 +57,12 @@ import (
 //	defer recoverCancel(cancel)
 func recoverCancel(cancel context.CancelCauseFunc) {
 	if r := recover(); r != nil {
-		if err, ok := r.(error); ok {
-			cancel(err)
-			return
-		}
-		cancel(fmt.Errorf("%v", r))
+		cancel(newPanicError(r))
 	}
 }
 
 // recoverCancelForParallel is a specialized panic recovery function for parallel task execution.
-// It handles panics in parallel goroutines by converting them to errors and sending them
+// It handles panics in parallel goroutines by converting them to a PanicError and sending it
 // through a channel for centralized error collection.
 //
 // This function is specifically designed for use in parallel task execution scenarios
@@ -47,8 +74,7 @@ func recoverCancel(cancel context.CancelCauseFunc) {
 //
 // Behavior:
 //   - Recovers from panic if one occurred in the goroutine
-//   - Converts error-type panics directly and sends to channel
-//   - Converts non-error panics to formatted error messages
+//   - Wraps the panic value, whatever its type, into a *PanicError and sends it to ch
 //   - Ensures that panicked goroutines still report their status
 //   - Does nothing if no panic occurred (normal completion)
 //
@@ -57,11 +83,7 @@ func recoverCancel(cancel context.CancelCauseFunc) {
 //	defer recoverCancelForParallel(errorChannel)
 func recoverCancelForParallel(ch chan<- error) {
 	if r := recover(); r != nil {
-		if err, ok := r.(error); ok {
-			ch <- err
-			return
-		}
-		ch <- fmt.Errorf("%v", r)
+		ch <- newPanicError(r)
 	}
 }
 
@@ -69,20 +91,12 @@ func recoverCancelForParallel(ch chan<- error) {
 // This utility function provides consistent error conversion from panic values,
 // ensuring that all panic types are properly transformed into errors.
 //
-// The function handles the common pattern of panic recovery where the panic
-// value might already be an error or might be some other type that needs
-// to be converted to an error.
-//
 // Parameters:
 //   - r: The recovered panic value (interface{})
 //
 // Returns:
-//   - error: Properly formatted error representing the panic
-//
-// Behavior:
-//   - Returns the error as-is if panic value is already an error type
-//   - Converts non-error panic values to formatted error messages
-//   - Ensures consistent error handling across panic scenarios
+//   - error: A *PanicError wrapping r together with the stack trace captured
+//     at the point of recovery
 //
 // Example:
 //
@@ -93,8 +107,5 @@ func recoverCancelForParallel(ch chan<- error) {
 //	    }
 //	}()
 func makeErrorFromPanic(r interface{}) error {
-	if err, ok := r.(error); ok {
-		return err
-	}
-	return fmt.Errorf("%v", r)
+	return newPanicError(r)
 }
@@ -0,0 +1,157 @@
+package goture
+
+import (
+	"context"
+	"sync"
+)
+
+// NewLimitedParallelGoture behaves like NewParallelGoture but caps the
+// number of tasks running concurrently to limit, using a buffered semaphore
+// channel of size limit. A non-positive limit means no cap, matching
+// NewParallelGoture.
+//
+// Example:
+//
+//	future := NewLimitedParallelGoture(ctx, 4, tasks...)
+//	if err := future.Wait(); err != nil {
+//	    log.Printf("One or more parallel tasks failed: %v", err)
+//	}
+func NewLimitedParallelGoture(parentCtx context.Context, limit int, tasks ...Task) Goture {
+	if limit <= 0 {
+		return NewParallelGoture(parentCtx, tasks...)
+	}
+	if len(tasks) == 0 {
+		localCtx, cancel := context.WithCancelCause(parentCtx)
+		cancel(SuccessResult{})
+		return Goture{ctx: localCtx}
+	}
+
+	var localCtx, cancel = context.WithCancelCause(parentCtx)
+	sem := make(chan struct{}, limit)
+	completed := make(chan error, len(tasks))
+
+	for _, fn := range tasks {
+		go func(task Task) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			defer recoverCancelForParallel(completed)
+			completed <- task(localCtx)
+		}(fn)
+	}
+
+	go func() {
+		var firstError error
+		for i := 0; i < len(tasks); i++ {
+			if err := <-completed; err != nil && firstError == nil {
+				firstError = err
+			}
+		}
+		if firstError != nil {
+			cancel(firstError)
+		} else {
+			cancel(SuccessResult{})
+		}
+	}()
+
+	return Goture{ctx: localCtx}
+}
+
+// ParallelBuilder incrementally assembles a bounded-concurrency parallel
+// Goture, letting callers add tasks one at a time via Go or TryGo before
+// collecting the result with Wait. It mirrors errgroup.Group's SetLimit and
+// TryGo, waiting for every launched task to complete like NewParallelGoture
+// rather than failing fast.
+type ParallelBuilder struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	sem    chan struct{}
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	firstErr error
+}
+
+// NewParallelBuilder creates an empty ParallelBuilder bound to parentCtx.
+func NewParallelBuilder(parentCtx context.Context) *ParallelBuilder {
+	localCtx, cancel := context.WithCancelCause(parentCtx)
+	return &ParallelBuilder{ctx: localCtx, cancel: cancel}
+}
+
+// SetLimit caps the number of tasks the builder runs concurrently to n. A
+// non-positive n removes the cap. It must be called before any call to Go
+// or TryGo.
+func (b *ParallelBuilder) SetLimit(n int) *ParallelBuilder {
+	if n > 0 {
+		b.sem = make(chan struct{}, n)
+	} else {
+		b.sem = nil
+	}
+	return b
+}
+
+// Go adds task to the builder, blocking until a concurrency slot is free if
+// a limit has been set via SetLimit.
+func (b *ParallelBuilder) Go(task Task) {
+	if b.sem != nil {
+		b.sem <- struct{}{}
+	}
+	b.wg.Add(1)
+	go b.run(task)
+}
+
+// TryGo adds task to the builder if a concurrency slot is immediately
+// available, returning false without running task when the configured
+// limit is already saturated.
+func (b *ParallelBuilder) TryGo(task Task) bool {
+	if b.sem != nil {
+		select {
+		case b.sem <- struct{}{}:
+		default:
+			return false
+		}
+	}
+	b.wg.Add(1)
+	go b.run(task)
+	return true
+}
+
+func (b *ParallelBuilder) run(task Task) {
+	defer b.wg.Done()
+	if b.sem != nil {
+		defer func() { <-b.sem }()
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			b.recordError(makeErrorFromPanic(r))
+		}
+	}()
+	if err := task(b.ctx); err != nil {
+		b.recordError(err)
+	}
+}
+
+func (b *ParallelBuilder) recordError(err error) {
+	b.mu.Lock()
+	if b.firstErr == nil {
+		b.firstErr = err
+	}
+	b.mu.Unlock()
+}
+
+// Wait returns a Goture that completes once every task added so far has
+// finished, resolving to the first error encountered if any task failed.
+// No further tasks should be added to the builder after calling Wait.
+func (b *ParallelBuilder) Wait() Goture {
+	go func() {
+		b.wg.Wait()
+		b.mu.Lock()
+		err := b.firstErr
+		b.mu.Unlock()
+		if err != nil {
+			b.cancel(err)
+		} else {
+			b.cancel(SuccessResult{})
+		}
+	}()
+	return Goture{ctx: b.ctx}
+}
@@ -0,0 +1,80 @@
+package goture
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func concurrencyProbingTask(running, maxRunning *int32) Task {
+	return func(ctx context.Context) error {
+		n := atomic.AddInt32(running, 1)
+		for {
+			old := atomic.LoadInt32(maxRunning)
+			if n <= old || atomic.CompareAndSwapInt32(maxRunning, old, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(running, -1)
+		return nil
+	}
+}
+
+func TestNewLimitedParallelGotureEnforcesLimit(t *testing.T) {
+	var running, maxRunning int32
+	tasks := make([]Task, 5)
+	for i := range tasks {
+		tasks[i] = concurrencyProbingTask(&running, &maxRunning)
+	}
+
+	if err := NewLimitedParallelGoture(context.Background(), 2, tasks...).Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if maxRunning > 2 {
+		t.Fatalf("expected at most 2 concurrent tasks, observed %d", maxRunning)
+	}
+}
+
+func TestNewLimitedParallelGotureReturnsFirstError(t *testing.T) {
+	errBoom := errors.New("boom")
+	tasks := []Task{
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context) error { return errBoom },
+	}
+	if err := NewLimitedParallelGoture(context.Background(), 1, tasks...).Wait(); !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+}
+
+func TestParallelBuilderSetLimitEnforcesLimit(t *testing.T) {
+	var running, maxRunning int32
+	b := NewParallelBuilder(context.Background()).SetLimit(2)
+	for i := 0; i < 5; i++ {
+		b.Go(concurrencyProbingTask(&running, &maxRunning))
+	}
+	if err := b.Wait().Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if maxRunning > 2 {
+		t.Fatalf("expected at most 2 concurrent tasks, observed %d", maxRunning)
+	}
+}
+
+func TestParallelBuilderTryGoFailsWhenSaturated(t *testing.T) {
+	b := NewParallelBuilder(context.Background()).SetLimit(1)
+	b.Go(func(ctx context.Context) error {
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	})
+	// Give the first task a moment to claim the only slot.
+	time.Sleep(5 * time.Millisecond)
+	if b.TryGo(func(ctx context.Context) error { return nil }) {
+		t.Fatal("expected TryGo to fail while the limit is saturated")
+	}
+	if err := b.Wait().Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
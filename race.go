@@ -0,0 +1,112 @@
+package goture
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSiblingSucceeded is the cancellation cause NewAnyGoture uses to signal
+// the losing tasks to abort once one of their siblings has already
+// succeeded.
+var ErrSiblingSucceeded = errors.New("goture: a sibling task already succeeded")
+
+// NewFailFastParallelGoture executes all given tasks concurrently and, as
+// soon as any task returns a non-nil error, cancels the context passed to
+// every task with that error as the cause, so cooperating tasks observing
+// ctx.Done() can abort promptly instead of running to completion. Unlike
+// errgroup.Group.Wait, which always waits for every goroutine to return,
+// the returned Goture resolves as soon as the first error is observed;
+// any still-running tasks keep executing in the background and their
+// results, once available, are discarded. Callers that need to know all
+// tasks have actually stopped before reclaiming shared state should have
+// those tasks signal completion themselves once they observe ctx.Done().
+//
+// Example:
+//
+//	future := NewFailFastParallelGoture(ctx, task1, task2, task3)
+//	if err := future.Wait(); err != nil {
+//	    log.Printf("Aborted: %v", err)
+//	}
+func NewFailFastParallelGoture(parentCtx context.Context, tasks ...Task) Goture {
+	if len(tasks) == 0 {
+		localCtx, cancel := context.WithCancelCause(parentCtx)
+		cancel(SuccessResult{})
+		return Goture{ctx: localCtx}
+	}
+
+	var localCtx, cancel = context.WithCancelCause(parentCtx)
+	completed := make(chan error, len(tasks))
+
+	for _, fn := range tasks {
+		go func(task Task) {
+			defer recoverCancelForParallel(completed)
+			completed <- task(localCtx)
+		}(fn)
+	}
+
+	go func() {
+		remaining := len(tasks)
+		for remaining > 0 {
+			if err := <-completed; err != nil {
+				cancel(err)
+				return
+			}
+			remaining--
+		}
+		cancel(SuccessResult{})
+	}()
+
+	return Goture{ctx: localCtx}
+}
+
+// NewRaceGoture is an alias for NewFailFastParallelGoture, named for the
+// errgroup-style race semantics it implements: the first failing task wins
+// and cancels the rest.
+func NewRaceGoture(parentCtx context.Context, tasks ...Task) Goture {
+	return NewFailFastParallelGoture(parentCtx, tasks...)
+}
+
+// NewAnyGoture executes all given tasks concurrently and completes as soon
+// as the first task succeeds, canceling the remaining tasks' context with
+// ErrSiblingSucceeded. If every task fails, it resolves to the last error
+// encountered. This is useful for hedged requests, where only the first
+// successful response matters.
+func NewAnyGoture(parentCtx context.Context, tasks ...Task) Goture {
+	if len(tasks) == 0 {
+		localCtx, cancel := context.WithCancelCause(parentCtx)
+		cancel(SuccessResult{})
+		return Goture{ctx: localCtx}
+	}
+
+	// abortCtx is handed to the tasks and canceled with ErrSiblingSucceeded
+	// as soon as one of them wins, so the rest can stop early.
+	abortCtx, abort := context.WithCancelCause(parentCtx)
+	// resultCtx carries the outcome of the race itself, independent of the
+	// sentinel used to abort the losing tasks.
+	resultCtx, cancel := context.WithCancelCause(parentCtx)
+
+	completed := make(chan error, len(tasks))
+	for _, fn := range tasks {
+		go func(task Task) {
+			defer recoverCancelForParallel(completed)
+			completed <- task(abortCtx)
+		}(fn)
+	}
+
+	go func() {
+		var lastErr error
+		for i := 0; i < len(tasks); i++ {
+			if err := <-completed; err == nil {
+				abort(ErrSiblingSucceeded)
+				cancel(SuccessResult{})
+				return
+			} else {
+				lastErr = err
+			}
+		}
+		abort(ErrSiblingSucceeded)
+		cancel(lastErr)
+	}()
+
+	return Goture{ctx: resultCtx}
+}
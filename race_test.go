@@ -0,0 +1,82 @@
+package goture
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewFailFastParallelGotureCancelsSiblingsOnError(t *testing.T) {
+	errBoom := errors.New("boom")
+	observed := make(chan bool, 1)
+
+	f := NewFailFastParallelGoture(context.Background(),
+		func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			return errBoom
+		},
+		func(ctx context.Context) error {
+			<-ctx.Done()
+			observed <- true
+			return ctx.Err()
+		},
+	)
+
+	if err := f.Wait(); !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+	select {
+	case <-observed:
+	case <-time.After(time.Second):
+		t.Fatal("sibling never observed cancellation")
+	}
+}
+
+func TestNewAnyGotureResolvesOnFirstSuccess(t *testing.T) {
+	f := NewAnyGoture(context.Background(),
+		func(ctx context.Context) error {
+			time.Sleep(30 * time.Millisecond)
+			return errors.New("slow failure")
+		},
+		func(ctx context.Context) error { return nil },
+	)
+	if err := f.Wait(); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+}
+
+func TestNewAnyGotureCancelsLosersWithSentinel(t *testing.T) {
+	loserErr := make(chan error, 1)
+	f := NewAnyGoture(context.Background(),
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context) error {
+			<-ctx.Done()
+			loserErr <- context.Cause(ctx)
+			return ctx.Err()
+		},
+	)
+	if err := f.Wait(); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	select {
+	case err := <-loserErr:
+		if !errors.Is(err, ErrSiblingSucceeded) {
+			t.Fatalf("expected ErrSiblingSucceeded, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("loser never observed cancellation")
+	}
+}
+
+func TestNewAnyGotureReturnsLastErrorWhenAllFail(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	f := NewAnyGoture(context.Background(),
+		func(ctx context.Context) error { return err1 },
+		func(ctx context.Context) error { return err2 },
+	)
+	if err := f.Wait(); err == nil {
+		t.Fatal("expected an error when every task fails")
+	}
+}
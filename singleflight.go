@@ -0,0 +1,153 @@
+package goture
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrGoexit is the cancellation cause used when a Group call's function
+// invokes runtime.Goexit instead of returning normally or panicking, since
+// that leaves no panic value or error for recover to observe.
+var ErrGoexit = errors.New("goture: task called runtime.Goexit")
+
+// errAllCallersCanceled is the cancellation cause used to tear down a
+// shared call's execution once every waiter has given up on it.
+var errAllCallersCanceled = errors.New("goture: all callers canceled")
+
+// call tracks a single in-flight Group execution shared by any number of
+// waiters.
+type call[V any] struct {
+	future GotureT[V]
+	cancel context.CancelCauseFunc
+
+	mu   sync.Mutex
+	refs int
+}
+
+// Group suppresses duplicate in-flight calls keyed by K, so that concurrent
+// callers requesting the same key share a single execution and its result,
+// in the spirit of golang.org/x/sync/singleflight.
+type Group[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+// NewGroup creates an empty Group ready for use.
+func NewGroup[K comparable, V any]() *Group[K, V] {
+	return &Group[K, V]{calls: make(map[K]*call[V])}
+}
+
+// Do executes fn for key, or joins an already in-flight call for the same
+// key if one exists, returning a GotureT[V] shared by every caller currently
+// waiting on key.
+//
+// Each caller supplies its own ctx. Canceling one caller's ctx does not
+// cancel the shared execution; it is only canceled once every waiter for
+// key has canceled, since at that point no one remains to observe the
+// result.
+func (g *Group[K, V]) Do(ctx context.Context, key K, fn TaskT[V]) GotureT[V] {
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		c.mu.Lock()
+		c.refs++
+		c.mu.Unlock()
+		g.mu.Unlock()
+		g.waitAndRelease(ctx, key, c)
+		return c.future
+	}
+
+	sharedCtx, cancel := context.WithCancelCause(context.Background())
+	var result V
+	c := &call[V]{
+		future: GotureT[V]{ctx: sharedCtx, result: &result},
+		cancel: cancel,
+		refs:   1,
+	}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	var ranToCompletion atomic.Bool
+	go func() {
+		defer g.forget(key, c)
+		defer func() {
+			if r := recover(); r != nil {
+				cancel(newPanicError(r))
+				return
+			}
+			if !ranToCompletion.Load() {
+				cancel(ErrGoexit)
+			}
+		}()
+		r, err := fn(sharedCtx)
+		ranToCompletion.Store(true)
+		if err != nil {
+			cancel(err)
+			return
+		}
+		result = r
+		cancel(SuccessResult{})
+	}()
+
+	g.waitAndRelease(ctx, key, c)
+	return c.future
+}
+
+// waitAndRelease decrements c's waiter refcount once ctx is done (the caller
+// gave up) or the call itself completes, canceling the shared execution once
+// the refcount reaches zero.
+func (g *Group[K, V]) waitAndRelease(ctx context.Context, key K, c *call[V]) {
+	go func() {
+		select {
+		case <-c.future.ctx.Done():
+			return
+		case <-ctx.Done():
+		}
+		c.mu.Lock()
+		c.refs--
+		remaining := c.refs
+		c.mu.Unlock()
+		if remaining == 0 {
+			c.cancel(errAllCallersCanceled)
+		}
+	}()
+}
+
+// forget removes c from the map of in-flight calls for key, provided it is
+// still the call registered for key (a newer call may have already replaced
+// it), so that the next Do for key starts a fresh execution.
+func (g *Group[K, V]) forget(key K, c *call[V]) {
+	g.mu.Lock()
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+	g.mu.Unlock()
+}
+
+// Forget removes key from the group's in-flight calls, if present, so that a
+// subsequent Do starts a new execution rather than joining one already
+// running.
+func (g *Group[K, V]) Forget(key K) {
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+}
+
+// Result is the outcome delivered on a DoChan channel.
+type Result[V any] struct {
+	Val V
+	Err error
+}
+
+// DoChan is like Do but returns a channel that receives the Result once it
+// is available, instead of a Goture.
+func (g *Group[K, V]) DoChan(ctx context.Context, key K, fn TaskT[V]) <-chan Result[V] {
+	future := g.Do(ctx, key, fn)
+	ch := make(chan Result[V], 1)
+	go func() {
+		v, err := future.Wait()
+		ch <- Result[V]{Val: v, Err: err}
+	}()
+	return ch
+}
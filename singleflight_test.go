@@ -0,0 +1,157 @@
+package goture
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestGroupDoDeduplicates exercises the flagship use case: many concurrent
+// callers for the same key must share a single execution of fn.
+func TestGroupDoDeduplicates(t *testing.T) {
+	g := NewGroup[string, int]()
+	var calls int32
+
+	fn := func(ctx context.Context) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		return 42, nil
+	}
+
+	const callers = 20
+	results := make(chan int, callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			v, err := g.Do(context.Background(), "k", fn).Wait()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			results <- v
+		}()
+	}
+	for i := 0; i < callers; i++ {
+		if v := <-results; v != 42 {
+			t.Errorf("expected 42, got %d", v)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected fn to run once, ran %d times", got)
+	}
+}
+
+// TestGroupDoSurvivesPartialCancellation verifies that one waiter giving up
+// does not abort the shared call while another waiter is still attached.
+func TestGroupDoSurvivesPartialCancellation(t *testing.T) {
+	g := NewGroup[string, int]()
+	fn := func(ctx context.Context) (int, error) {
+		time.Sleep(30 * time.Millisecond)
+		return 7, nil
+	}
+
+	ctxA, cancelA := context.WithCancel(context.Background())
+	_ = g.Do(ctxA, "k", fn)
+	fB := g.Do(context.Background(), "k", fn)
+
+	cancelA()
+	v, err := fB.Wait()
+	if err != nil || v != 7 {
+		t.Fatalf("expected (7, nil) despite other waiter canceling, got (%d, %v)", v, err)
+	}
+}
+
+// TestGroupDoAbortsWhenAllWaitersCancel verifies that once every waiter for
+// a key has canceled, the shared execution is canceled too instead of
+// running to completion unobserved.
+func TestGroupDoAbortsWhenAllWaitersCancel(t *testing.T) {
+	g := NewGroup[string, int]()
+	fn := func(ctx context.Context) (int, error) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f := g.Do(ctx, "k", fn)
+	cancel()
+
+	start := time.Now()
+	if _, err := f.Wait(); err == nil {
+		t.Fatal("expected an error once all waiters canceled")
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Fatalf("expected prompt abort, took %s", elapsed)
+	}
+}
+
+// TestGroupDoDetectsGoexit verifies that a call function invoking
+// runtime.Goexit, rather than returning or panicking, resolves the shared
+// GotureT with ErrGoexit instead of hanging forever.
+func TestGroupDoDetectsGoexit(t *testing.T) {
+	g := NewGroup[string, int]()
+	f := g.Do(context.Background(), "k", func(ctx context.Context) (int, error) {
+		runtime.Goexit()
+		return 0, nil // unreachable
+	})
+
+	if _, err := f.Wait(); !errors.Is(err, ErrGoexit) {
+		t.Fatalf("expected ErrGoexit, got %v", err)
+	}
+}
+
+// TestGroupForgetStartsFreshExecution verifies that Forget causes the next
+// Do for the same key to start a new execution rather than join the one
+// still in flight.
+func TestGroupForgetStartsFreshExecution(t *testing.T) {
+	g := NewGroup[string, int]()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	f1 := g.Do(context.Background(), "k", func(ctx context.Context) (int, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	<-started
+	g.Forget("k")
+
+	var freshCalls int32
+	f2 := g.Do(context.Background(), "k", func(ctx context.Context) (int, error) {
+		atomic.AddInt32(&freshCalls, 1)
+		return 2, nil
+	})
+
+	v2, err := f2.Wait()
+	if err != nil || v2 != 2 {
+		t.Fatalf("expected the fresh call to resolve (2, nil), got (%d, %v)", v2, err)
+	}
+	if got := atomic.LoadInt32(&freshCalls); got != 1 {
+		t.Fatalf("expected the fresh fn to run once, ran %d times", got)
+	}
+
+	close(release)
+	v1, err := f1.Wait()
+	if err != nil || v1 != 1 {
+		t.Fatalf("expected the original call to still resolve independently, got (%d, %v)", v1, err)
+	}
+}
+
+// TestGroupDoChanDeliversResult exercises the DoChan variant end to end.
+func TestGroupDoChanDeliversResult(t *testing.T) {
+	g := NewGroup[string, int]()
+	ch := g.DoChan(context.Background(), "k", func(ctx context.Context) (int, error) { return 5, nil })
+
+	select {
+	case res := <-ch:
+		if res.Err != nil || res.Val != 5 {
+			t.Fatalf("expected (5, nil), got (%d, %v)", res.Val, res.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("DoChan never delivered a result")
+	}
+}